@@ -73,7 +73,7 @@ var facadeVersions = facades.FacadeVersions{
 	"ImageMetadataManager":         {1},
 	"InstanceMutater":              {3},
 	"InstancePoller":               {4},
-	"KeyManager":                   {1},
+	"KeyManager":                   {1, 2},
 	"KeyUpdater":                   {1},
 	"LeadershipService":            {2},
 	"LifeFlag":                     {1},
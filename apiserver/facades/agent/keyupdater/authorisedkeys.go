@@ -4,18 +4,31 @@
 package keyupdater
 
 import (
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/loggo"
 	"github.com/juju/names/v5"
 	"github.com/juju/utils/v3/ssh"
 
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	environsconfig "github.com/juju/juju/environs/config"
+	pkissh "github.com/juju/juju/pki/ssh"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/watcher"
 )
 
+var logger = loggo.GetLogger("juju.apiserver.keyupdater")
+
+// The comment values used by juju internal ssh keys, which are never
+// subject to the controller's ssh key policy.
+var internalComments = set.NewStrings("juju-client-key", environsconfig.JujuSystemKey)
+
 // KeyUpdater defines the methods on the keyupdater API end point.
 type KeyUpdater interface {
 	AuthorisedKeys(args params.Entities) (params.StringsResults, error)
@@ -30,6 +43,7 @@ type KeyUpdaterAPI struct {
 	resources  facade.Resources
 	authorizer facade.Authorizer
 	getCanRead common.GetAuthFunc
+	clock      clock.Clock
 }
 
 var _ KeyUpdater = (*KeyUpdaterAPI)(nil)
@@ -38,6 +52,9 @@ var _ KeyUpdater = (*KeyUpdaterAPI)(nil)
 // for the specified machines.
 // The current implementation relies on global authorised keys being stored in the model config.
 // This will change as new user management and authorisation functionality is added.
+// Controller config is also watched, as the ssh key policy it holds
+// determines which of those keys are handed out, and the watcher fires
+// when a key's expiry-time passes.
 func (api *KeyUpdaterAPI) WatchAuthorisedKeys(arg params.Entities) (params.NotifyWatchResults, error) {
 	results := make([]params.NotifyWatchResult, len(arg.Entities))
 
@@ -66,7 +83,14 @@ func (api *KeyUpdaterAPI) WatchAuthorisedKeys(arg params.Entities) (params.Notif
 			continue
 		}
 		// 3. Watch for changes
-		watch := api.model.WatchForModelConfigChanges()
+		watch := newKeyExpiryWatcher(
+			common.NewMultiNotifyWatcher(
+				api.model.WatchForModelConfigChanges(),
+				api.state.WatchControllerConfig(),
+			),
+			api.clock,
+			api.nextKeyExpiry,
+		)
 		// Consume the initial event.
 		if _, ok := <-watch.Changes(); ok {
 			results[i].NotifyWatcherId = api.resources.Register(watch)
@@ -91,7 +115,7 @@ func (api *KeyUpdaterAPI) AuthorisedKeys(arg params.Entities) (params.StringsRes
 	var keys []string
 	config, configErr := api.model.ModelConfig()
 	if configErr == nil {
-		keys = ssh.SplitAuthorisedKeys(config.AuthorizedKeys())
+		keys, configErr = api.allowedKeys(ssh.SplitAuthorisedKeys(config.AuthorizedKeys()))
 	}
 
 	canRead, err := api.getCanRead()
@@ -128,3 +152,58 @@ func (api *KeyUpdaterAPI) AuthorisedKeys(arg params.Entities) (params.StringsRes
 	}
 	return params.StringsResults{Results: results}, nil
 }
+
+// allowedKeys filters out the keys that do not meet the controller's ssh
+// key policy, or whose expiry-time has passed, so that machines stop
+// accepting them. Juju's internal keys, keys that cannot be parsed and
+// keys with an expiry-time not in UTC are passed through unchanged; sshd
+// enforces the expiry-time itself, in the machine's local timezone.
+func (api *KeyUpdaterAPI) allowedKeys(keys []string) ([]string, error) {
+	controllerConfig, err := api.state.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	policy := controllerConfig.SSHKeyPolicy()
+	var allowed []string
+	for _, key := range keys {
+		fingerprint, comment, err := ssh.KeyFingerprint(key)
+		if err == nil && !internalComments.Contains(comment) {
+			if err := policy.CheckAuthorisedKey(key); err != nil {
+				logger.Debugf("not authorising ssh key %s: %v", fingerprint, err)
+				continue
+			}
+			if expiry, ok, err := pkissh.KeyExpiry(key); err == nil && ok && !api.clock.Now().Before(expiry) {
+				logger.Debugf("not authorising ssh key %s: expired at %s", fingerprint, expiry)
+				continue
+			}
+		}
+		allowed = append(allowed, key)
+	}
+	return allowed, nil
+}
+
+// nextKeyExpiry returns the earliest expiry-time still to come among the
+// authorised keys that allowedKeys would expire. The returned bool is
+// false if there is no such key.
+func (api *KeyUpdaterAPI) nextKeyExpiry() (time.Time, bool, error) {
+	config, err := api.model.ModelConfig()
+	if err != nil {
+		return time.Time{}, false, errors.Trace(err)
+	}
+	now := api.clock.Now()
+	var next time.Time
+	for _, key := range ssh.SplitAuthorisedKeys(config.AuthorizedKeys()) {
+		_, comment, err := ssh.KeyFingerprint(key)
+		if err != nil || internalComments.Contains(comment) {
+			continue
+		}
+		expiry, ok, err := pkissh.KeyExpiry(key)
+		if err != nil || !ok || !expiry.After(now) {
+			continue
+		}
+		if next.IsZero() || expiry.Before(next) {
+			next = expiry
+		}
+	}
+	return next, !next.IsZero(), nil
+}
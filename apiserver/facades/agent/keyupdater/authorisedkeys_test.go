@@ -4,6 +4,10 @@
 package keyupdater_test
 
 import (
+	"strings"
+	"time"
+
+	"github.com/juju/clock/testclock"
 	"github.com/juju/names/v5"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -12,10 +16,14 @@ import (
 	"github.com/juju/juju/apiserver/facade/facadetest"
 	"github.com/juju/juju/apiserver/facades/agent/keyupdater"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
+	environsconfig "github.com/juju/juju/environs/config"
 	jujutesting "github.com/juju/juju/juju/testing"
+	pkisshtesting "github.com/juju/juju/pki/ssh/testing"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 	statetesting "github.com/juju/juju/state/testing"
+	coretesting "github.com/juju/juju/testing"
 )
 
 type authorisedKeysSuite struct {
@@ -126,6 +134,83 @@ func (s *authorisedKeysSuite) TestWatchAuthorisedKeys(c *gc.C) {
 	wc.AssertClosed()
 }
 
+func (s *authorisedKeysSuite) TestWatchAuthorisedKeysControllerConfig(c *gc.C) {
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.rawMachine.Tag().String()},
+		},
+	}
+	results, err := s.keyupdater.WatchAuthorisedKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	resource := s.resources.Get(results.Results[0].NotifyWatcherId)
+	c.Assert(resource, gc.NotNil)
+
+	w := resource.(state.NotifyWatcher)
+	wc := statetesting.NewNotifyWatcherC(c, w)
+	wc.AssertNoChange()
+
+	err = s.State.UpdateControllerConfig(map[string]interface{}{
+		controller.SSHKeyMinRSABits: 3072,
+	}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	wc.AssertOneChange()
+	statetesting.AssertStop(c, w)
+	wc.AssertClosed()
+}
+
+func (s *authorisedKeysSuite) TestWatchAuthorisedKeysExpiry(c *gc.C) {
+	clock := testclock.NewClock(time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC))
+	api, err := keyupdater.NewKeyUpdaterAPIWithClock(facadetest.Context{
+		State_:     s.State,
+		Resources_: s.resources,
+		Auth_:      s.authorizer,
+	}, clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	expiringKey := `expiry-time="20261016000100Z" ` + pkisshtesting.ED25519Key + " user@host"
+	// Keys with an expiry-time not in UTC are left to sshd, so do not
+	// trigger the watcher.
+	localKey := `expiry-time="20261016000030" ` + pkisshtesting.ECDSAP256Key + " user@host"
+	s.setAuthorizedKeys(c, strings.Join([]string{expiringKey, localKey}, "\n"))
+
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.rawMachine.Tag().String()},
+		},
+	}
+	results, err := api.WatchAuthorisedKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	resource := s.resources.Get(results.Results[0].NotifyWatcherId)
+	c.Assert(resource, gc.NotNil)
+
+	w := resource.(state.NotifyWatcher)
+	wc := statetesting.NewNotifyWatcherC(c, w)
+	wc.AssertNoChange()
+
+	err = clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	keys, err := api.AuthorisedKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(keys, gc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{
+			{Result: []string{localKey}},
+		},
+	})
+
+	// Nothing further is due to expire.
+	clock.Advance(time.Hour)
+	wc.AssertNoChange()
+	statetesting.AssertStop(c, w)
+	wc.AssertClosed()
+}
+
 func (s *authorisedKeysSuite) TestAuthorisedKeysForNoone(c *gc.C) {
 	// Not an error to request nothing, dumb, but not an error.
 	results, err := s.keyupdater.AuthorisedKeys(params.Entities{})
@@ -153,3 +238,49 @@ func (s *authorisedKeysSuite) TestAuthorisedKeys(c *gc.C) {
 		},
 	})
 }
+
+func (s *authorisedKeysSuite) TestAuthorisedKeysKeyPolicy(c *gc.C) {
+	err := s.State.UpdateControllerConfig(map[string]interface{}{
+		controller.SSHKeyMinRSABits: 3072,
+	}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	rsaKey := pkisshtesting.RSA2048Key + " user@host"
+	systemKey := pkisshtesting.RSA2048Key + " " + environsconfig.JujuSystemKey
+	ed25519Key := pkisshtesting.ED25519Key + " user@host"
+	s.setAuthorizedKeys(c, strings.Join([]string{rsaKey, systemKey, ed25519Key, "key1"}, "\n"))
+
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.rawMachine.Tag().String()},
+		},
+	}
+	results, err := s.keyupdater.AuthorisedKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{
+			{Result: []string{systemKey, ed25519Key, "key1"}},
+		},
+	})
+}
+
+func (s *authorisedKeysSuite) TestAuthorisedKeysDropsExpired(c *gc.C) {
+	expiredKey := `expiry-time="20200101Z" ` + pkisshtesting.ED25519Key + " user@host"
+	validKey := `expiry-time="29991231Z" ` + pkisshtesting.ECDSAP256Key + " user@host"
+	// Timespecs not in UTC are left for sshd to enforce.
+	localKey := `expiry-time="20200101" ` + pkisshtesting.RSA3072Key + " user@host"
+	s.setAuthorizedKeys(c, strings.Join([]string{expiredKey, validKey, localKey}, "\n"))
+
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.rawMachine.Tag().String()},
+		},
+	}
+	results, err := s.keyupdater.AuthorisedKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{
+			{Result: []string{validKey, localKey}},
+		},
+	})
+}
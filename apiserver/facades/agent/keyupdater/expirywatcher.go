@@ -0,0 +1,109 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package keyupdater
+
+import (
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher"
+)
+
+// keyExpiryWatcher is a notify watcher that passes on the events of its
+// source watcher, and also fires when the earliest upcoming key
+// expiry-time passes, so that machines stop accepting the expired key.
+type keyExpiryWatcher struct {
+	tomb       tomb.Tomb
+	source     state.NotifyWatcher
+	clock      clock.Clock
+	nextExpiry func() (time.Time, bool, error)
+	out        chan struct{}
+}
+
+// newKeyExpiryWatcher returns a watcher that takes ownership of source.
+// nextExpiry is consulted after each event to find the next time at
+// which to fire; it returns false if there is nothing left to expire.
+func newKeyExpiryWatcher(
+	source state.NotifyWatcher,
+	clock clock.Clock,
+	nextExpiry func() (time.Time, bool, error),
+) state.NotifyWatcher {
+	w := &keyExpiryWatcher{
+		source:     source,
+		clock:      clock,
+		nextExpiry: nextExpiry,
+		out:        make(chan struct{}),
+	}
+	w.tomb.Go(func() error {
+		defer close(w.out)
+		defer watcher.Stop(source, &w.tomb)
+		return w.loop()
+	})
+	return w
+}
+
+// Stop stops the watcher, and returns any error encountered while running
+// or shutting down.
+func (w *keyExpiryWatcher) Stop() error {
+	w.Kill()
+	return w.Wait()
+}
+
+// Kill kills the watcher without waiting for it to shut down.
+func (w *keyExpiryWatcher) Kill() {
+	w.tomb.Kill(nil)
+}
+
+// Wait waits for the watcher to die and returns any
+// error encountered when it was running.
+func (w *keyExpiryWatcher) Wait() error {
+	return w.tomb.Wait()
+}
+
+// Err returns any error encountered while running or shutting down, or
+// tomb.ErrStillAlive if the watcher is still running.
+func (w *keyExpiryWatcher) Err() error {
+	return w.tomb.Err()
+}
+
+// Changes returns the event channel for the keyExpiryWatcher.
+func (w *keyExpiryWatcher) Changes() <-chan struct{} {
+	return w.out
+}
+
+func (w *keyExpiryWatcher) loop() error {
+	var (
+		out    chan struct{}
+		expiry <-chan time.Time
+	)
+	for {
+		select {
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		case _, ok := <-w.source.Changes():
+			if !ok {
+				return watcher.EnsureErr(w.source)
+			}
+		case <-expiry:
+		case out <- struct{}{}:
+			out = nil
+			continue
+		}
+		// Either the keys changed or one has just expired, so the
+		// timer is re-armed for whichever key expires next.
+		next, ok, err := w.nextExpiry()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		expiry = nil
+		if ok {
+			expiry = w.clock.After(next.Sub(w.clock.Now()))
+		}
+		out = w.out
+	}
+}
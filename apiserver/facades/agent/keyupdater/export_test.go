@@ -3,6 +3,23 @@
 
 package keyupdater
 
+import (
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/apiserver/facade"
+)
+
 var (
 	NewKeyUpdaterAPI = newKeyUpdaterAPI
 )
+
+// NewKeyUpdaterAPIWithClock returns a KeyUpdaterAPI that uses the given
+// clock to expire keys.
+func NewKeyUpdaterAPIWithClock(ctx facade.Context, clock clock.Clock) (*KeyUpdaterAPI, error) {
+	api, err := newKeyUpdaterAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	api.clock = clock
+	return api, nil
+}
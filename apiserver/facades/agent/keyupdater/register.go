@@ -6,6 +6,7 @@ package keyupdater
 import (
 	"reflect"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/apiserver/common"
@@ -42,5 +43,6 @@ func newKeyUpdaterAPI(ctx facade.Context) (*KeyUpdaterAPI, error) {
 		resources:  ctx.Resources(),
 		authorizer: authorizer,
 		getCanRead: getCanRead,
+		clock:      clock.WallClock,
 	}, nil
 }
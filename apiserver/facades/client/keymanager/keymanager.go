@@ -6,7 +6,9 @@ package keymanager
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/collections/set"
 	"github.com/juju/collections/transform"
 	"github.com/juju/errors"
@@ -20,6 +22,7 @@ import (
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/environs/config"
+	pkissh "github.com/juju/juju/pki/ssh"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -30,13 +33,21 @@ var internalComments = set.NewStrings("juju-client-key", config.JujuSystemKey)
 
 // KeyManagerAPI provides api endpoints for manipulating ssh keys
 type KeyManagerAPI struct {
-	model      Model
-	authorizer facade.Authorizer
-	check      BlockChecker
+	model            Model
+	controllerConfig ControllerConfigGetter
+	authorizer       facade.Authorizer
+	check            BlockChecker
+	clock            clock.Clock
 
 	controllerTag names.ControllerTag
 }
 
+// KeyManagerAPIV1 provides api endpoints for manipulating ssh keys for
+// the KeyManager facade v1.
+type KeyManagerAPIV1 struct {
+	*KeyManagerAPI
+}
+
 func (api *KeyManagerAPI) checkCanRead(sshUser string) error {
 	if err := api.checkCanWrite(sshUser); err == nil {
 		return nil
@@ -77,7 +88,19 @@ func (api *KeyManagerAPI) checkCanWrite(sshUser string) error {
 }
 
 // ListKeys returns the authorised ssh keys for the specified users.
+// Fingerprints are not annotated with key expiry in v1.
+func (api *KeyManagerAPIV1) ListKeys(arg params.ListSSHKeys) (params.StringsResults, error) {
+	return api.listKeys(arg, false)
+}
+
+// ListKeys returns the authorised ssh keys for the specified users.
+// When listing fingerprints, keys with an expiry-time are annotated with
+// when they expire.
 func (api *KeyManagerAPI) ListKeys(arg params.ListSSHKeys) (params.StringsResults, error) {
+	return api.listKeys(arg, true)
+}
+
+func (api *KeyManagerAPI) listKeys(arg params.ListSSHKeys, withExpiry bool) (params.StringsResults, error) {
 	if len(arg.Entities.Entities) == 0 {
 		return params.StringsResults{}, nil
 	}
@@ -93,7 +116,11 @@ func (api *KeyManagerAPI) ListKeys(arg params.ListSSHKeys) (params.StringsResult
 		return params.StringsResults{Results: results}, nil
 	}
 	keys := ssh.SplitAuthorisedKeys(cfg.AuthorizedKeys())
-	keyInfo := parseKeys(keys, arg.Mode)
+	var now time.Time
+	if withExpiry {
+		now = api.clock.Now()
+	}
+	keyInfo := parseKeys(keys, arg.Mode, now)
 
 	results := transform.Slice(arg.Entities.Entities, func(entity params.Entity) params.StringsResult {
 		// NOTE: entity.Tag isn't a tag, but a username.
@@ -106,7 +133,9 @@ func (api *KeyManagerAPI) ListKeys(arg params.ListSSHKeys) (params.StringsResult
 	return params.StringsResults{Results: results}, nil
 }
 
-func parseKeys(keys []string, mode ssh.ListMode) (keyInfo []string) {
+// parseKeys returns the key info to list for the keys. Fingerprints are
+// annotated with key expiry relative to now, unless now is zero.
+func parseKeys(keys []string, mode ssh.ListMode, now time.Time) (keyInfo []string) {
 	for _, key := range keys {
 		fingerprint, comment, err := ssh.KeyFingerprint(key)
 		if err != nil {
@@ -124,12 +153,35 @@ func parseKeys(keys []string, mode ssh.ListMode) (keyInfo []string) {
 			if comment != "" {
 				shortKey += fmt.Sprintf(" (%s)", comment)
 			}
+			if !now.IsZero() {
+				shortKey += expiryInfo(key, now)
+			}
 			keyInfo = append(keyInfo, shortKey)
 		}
 	}
 	return keyInfo
 }
 
+// expiryInfo describes when the key expires, if it has an expiry-time,
+// so that users are reminded to replace it in time. An expiry-time not in
+// UTC is shown as written, since each machine reads it in its own
+// timezone.
+func expiryInfo(key string, now time.Time) string {
+	expiry, ok, err := pkissh.KeyExpiry(key)
+	if errors.Is(err, pkissh.ErrExpiryTimeNotUTC) {
+		timespec, _, _ := pkissh.ExpiryTime(key)
+		return fmt.Sprintf(" [expires %s machine local time]", timespec)
+	} else if err != nil {
+		return " [invalid expiry]"
+	} else if !ok {
+		return ""
+	}
+	if !now.Before(expiry) {
+		return fmt.Sprintf(" [expired %s]", expiry.Format(time.RFC3339))
+	}
+	return fmt.Sprintf(" [expires %s]", expiry.Format(time.RFC3339))
+}
+
 func (api *KeyManagerAPI) writeSSHKeys(sshKeys []string) error {
 	// Write out the new keys.
 	keyStr := strings.Join(sshKeys, "\n")
@@ -163,6 +215,15 @@ func (api *KeyManagerAPI) currentKeyDataForAdd() (keys []string, fingerprints se
 	return keys, fingerprints, nil
 }
 
+// keyPolicy returns the controller's policy for user ssh keys.
+func (api *KeyManagerAPI) keyPolicy() (pkissh.KeyPolicy, error) {
+	cfg, err := api.controllerConfig.ControllerConfig()
+	if err != nil {
+		return pkissh.KeyPolicy{}, fmt.Errorf("reading controller config: %v", err)
+	}
+	return cfg.SSHKeyPolicy(), nil
+}
+
 // AddKeys adds new authorised ssh keys for the specified user.
 func (api *KeyManagerAPI) AddKeys(arg params.ModifyUserSSHKeys) (params.ErrorResults, error) {
 	if err := api.checkCanWrite(arg.User); err != nil {
@@ -180,8 +241,12 @@ func (api *KeyManagerAPI) AddKeys(arg params.ModifyUserSSHKeys) (params.ErrorRes
 	if err != nil {
 		return params.ErrorResults{}, apiservererrors.ServerError(fmt.Errorf("reading current key data: %v", err))
 	}
+	policy, err := api.keyPolicy()
+	if err != nil {
+		return params.ErrorResults{}, apiservererrors.ServerError(err)
+	}
 
-	// Ensure we are not going to add invalid or duplicate keys.
+	// Ensure we are not going to add invalid, duplicate or disallowed keys.
 	results := transform.Slice(arg.Keys, func(key string) params.ErrorResult {
 		fingerprint, comment, err := ssh.KeyFingerprint(key)
 		if err != nil {
@@ -193,6 +258,18 @@ func (api *KeyManagerAPI) AddKeys(arg params.ModifyUserSSHKeys) (params.ErrorRes
 		if currentFingerprints.Contains(fingerprint) {
 			return params.ErrorResult{Error: apiservererrors.ServerError(fmt.Errorf("duplicate ssh key: %s", key))}
 		}
+		if err := policy.CheckAuthorisedKey(key); err != nil {
+			return params.ErrorResult{Error: apiservererrors.ServerError(fmt.Errorf("%v: %s", err, key))}
+		}
+		if expiry, ok, err := pkissh.KeyExpiry(key); errors.Is(err, pkissh.ErrExpiryTimeNotUTC) {
+			timespec, _, _ := pkissh.ExpiryTime(key)
+			return params.ErrorResult{Error: apiservererrors.ServerError(fmt.Errorf(
+				"ssh key expiry-time %q must be in UTC, with a trailing Z: %s", timespec, key))}
+		} else if err != nil {
+			return params.ErrorResult{Error: apiservererrors.ServerError(fmt.Errorf("invalid ssh key expiry: %v: %s", err, key))}
+		} else if ok && !api.clock.Now().Before(expiry) {
+			return params.ErrorResult{Error: apiservererrors.ServerError(fmt.Errorf("ssh key expired at %s: %s", expiry.Format(time.RFC3339), key))}
+		}
 		currentFingerprints.Add(fingerprint)
 		sshKeys = append(sshKeys, key)
 		return params.ErrorResult{}
@@ -273,9 +350,14 @@ func (api *KeyManagerAPI) ImportKeys(arg params.ModifyUserSSHKeys) (params.Error
 		return params.ErrorResults{}, apiservererrors.ServerError(fmt.Errorf("reading current key data: %v", err))
 	}
 
+	policy, err := api.keyPolicy()
+	if err != nil {
+		return params.ErrorResults{}, apiservererrors.ServerError(err)
+	}
+
 	importedKeyInfo := runSSHKeyImport(arg.Keys)
 
-	// Ensure we are not going to add invalid or duplicate keys.
+	// Ensure we are not going to add invalid, duplicate or disallowed keys.
 	results := transform.Slice(arg.Keys, func(key string) params.ErrorResult {
 		compoundErr := ""
 		for _, keyInfo := range importedKeyInfo[key] {
@@ -291,6 +373,10 @@ func (api *KeyManagerAPI) ImportKeys(arg params.ModifyUserSSHKeys) (params.Error
 				compoundErr += fmt.Sprintf("%v\n", errors.Errorf("duplicate ssh key: %s", keyInfo.key))
 				continue
 			}
+			if err := policy.CheckAuthorisedKey(keyInfo.key); err != nil {
+				compoundErr += fmt.Sprintf("%v\n", errors.Errorf("%v: %s", err, keyInfo.key))
+				continue
+			}
 			sshKeys = append(sshKeys, keyInfo.key)
 		}
 		if compoundErr != "" {
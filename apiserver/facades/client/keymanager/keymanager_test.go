@@ -6,7 +6,9 @@ package keymanager_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/names/v5"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -20,7 +22,9 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/keymanager/mocks"
 	keymanagertesting "github.com/juju/juju/apiserver/facades/client/keymanager/testing"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/environs/config"
+	pkisshtesting "github.com/juju/juju/pki/ssh/testing"
 	"github.com/juju/juju/rpc/params"
 	coretesting "github.com/juju/juju/testing"
 )
@@ -28,10 +32,14 @@ import (
 type keyManagerSuite struct {
 	testing.CleanupSuite
 
-	model        *mocks.MockModel
-	blockChecker *mocks.MockBlockChecker
-	apiUser      names.UserTag
-	api          *keymanager.KeyManagerAPI
+	model            *mocks.MockModel
+	controllerConfig *mocks.MockControllerConfigGetter
+	blockChecker     *mocks.MockBlockChecker
+	apiUser          names.UserTag
+	api              *keymanager.KeyManagerAPI
+
+	controllerCfg controller.Config
+	clock         *testclock.Clock
 
 	authorizer apiservertesting.FakeAuthorizer
 }
@@ -41,18 +49,23 @@ var _ = gc.Suite(&keyManagerSuite{})
 func (s *keyManagerSuite) SetUpTest(c *gc.C) {
 	s.PatchValue(&keymanager.RunSSHImportId, keymanagertesting.FakeImport)
 	s.apiUser = names.NewUserTag("admin")
+	s.controllerCfg = coretesting.FakeControllerConfig()
+	s.clock = testclock.NewClock(time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC))
 }
 
 func (s *keyManagerSuite) setup(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.model = mocks.NewMockModel(ctrl)
 	s.model.EXPECT().ModelTag().Return(coretesting.ModelTag).AnyTimes()
+	s.controllerConfig = mocks.NewMockControllerConfigGetter(ctrl)
+	s.controllerConfig.EXPECT().ControllerConfig().Return(s.controllerCfg, nil).AnyTimes()
 	s.blockChecker = mocks.NewMockBlockChecker(ctrl)
 	s.authorizer = apiservertesting.FakeAuthorizer{
 		Tag: s.apiUser,
 	}
 
-	s.api = keymanager.NewKeyManagerAPI(s.model, s.authorizer, s.blockChecker, coretesting.ControllerTag)
+	s.api = keymanager.NewKeyManagerAPI(
+		s.model, s.controllerConfig, s.authorizer, s.blockChecker, s.clock, coretesting.ControllerTag)
 
 	return ctrl
 }
@@ -111,6 +124,61 @@ func (s *keyManagerSuite) TestListKeysHidesJujuInternal(c *gc.C) {
 	})
 }
 
+func (s *keyManagerSuite) TestListKeysShowsExpiry(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	key1 := `expiry-time="20261116Z" ` + sshtesting.ValidKeyOne.Key + " user@host"
+	key2 := `expiry-time="20261001Z" ` + sshtesting.ValidKeyTwo.Key
+	key3 := `expiry-time="tomorrow" ` + sshtesting.ValidKeyThree.Key
+	key4 := `expiry-time="20261116" ` + sshtesting.ValidKeyFour.Key
+	s.setAuthorizedKeys(c, key1, key2, key3, key4)
+
+	args := params.ListSSHKeys{
+		Entities: params.Entities{Entities: []params.Entity{
+			{Tag: names.NewUserTag("admin").String()},
+		}},
+		Mode: ssh.Fingerprints,
+	}
+	results, err := s.api.ListKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{{
+			Result: []string{
+				sshtesting.ValidKeyOne.Fingerprint + " (user@host) [expires 2026-11-16T00:00:00Z]",
+				sshtesting.ValidKeyTwo.Fingerprint + " [expired 2026-10-01T00:00:00Z]",
+				sshtesting.ValidKeyThree.Fingerprint + " [invalid expiry]",
+				sshtesting.ValidKeyFour.Fingerprint + " [expires 20261116 machine local time]",
+			},
+		}},
+	})
+}
+
+func (s *keyManagerSuite) TestListKeysV1OmitsExpiry(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	key1 := `expiry-time="20261116Z" ` + sshtesting.ValidKeyOne.Key + " user@host"
+	key2 := `expiry-time="tomorrow" ` + sshtesting.ValidKeyTwo.Key
+	s.setAuthorizedKeys(c, key1, key2)
+
+	args := params.ListSSHKeys{
+		Entities: params.Entities{Entities: []params.Entity{
+			{Tag: names.NewUserTag("admin").String()},
+		}},
+		Mode: ssh.Fingerprints,
+	}
+	api := &keymanager.KeyManagerAPIV1{KeyManagerAPI: s.api}
+	results, err := api.ListKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{{
+			Result: []string{
+				sshtesting.ValidKeyOne.Fingerprint + " (user@host)",
+				sshtesting.ValidKeyTwo.Fingerprint,
+			},
+		}},
+	})
+}
+
 func (s *keyManagerSuite) TestListJujuSystemKey(c *gc.C) {
 	defer s.setup(c).Finish()
 
@@ -222,6 +290,74 @@ func (s *keyManagerSuite) TestAddJujuSystemKey(c *gc.C) {
 	})
 }
 
+func (s *keyManagerSuite) TestAddKeysExpiry(c *gc.C) {
+	defer s.setup(c).Finish()
+	s.blockChecker.EXPECT().ChangeAllowed().Return(nil)
+
+	key1 := sshtesting.ValidKeyOne.Key + " user@host"
+	s.setAuthorizedKeys(c, key1)
+
+	validKey := `expiry-time="20261116Z" ` + sshtesting.ValidKeyTwo.Key
+	expiredKey := `expiry-time="20261001Z" ` + sshtesting.ValidKeyThree.Key
+	invalidKey := `expiry-time="soon" ` + sshtesting.ValidKeyFour.Key
+	localKey := `expiry-time="20261116" ` + pkisshtesting.ED25519Key
+
+	newAttrs := map[string]interface{}{
+		config.AuthorizedKeysKey: strings.Join([]string{key1, validKey}, "\n"),
+	}
+	s.model.EXPECT().UpdateModelConfig(newAttrs, nil)
+
+	args := params.ModifyUserSSHKeys{
+		User: names.NewUserTag("admin").Name(),
+		Keys: []string{validKey, expiredKey, invalidKey, localKey},
+	}
+	results, err := s.api.AddKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: nil},
+			{Error: apiservertesting.ServerError("ssh key expired at 2026-10-01T00:00:00Z: " + expiredKey)},
+			{Error: apiservertesting.ServerError(`invalid ssh key expiry: expiry-time "soon" not valid: ` + invalidKey)},
+			{Error: apiservertesting.ServerError(`ssh key expiry-time "20261116" must be in UTC, with a trailing Z: ` + localKey)},
+		},
+	})
+}
+
+func (s *keyManagerSuite) TestAddKeysKeyPolicy(c *gc.C) {
+	s.controllerCfg[controller.SSHKeyMinRSABits] = 3072
+	s.controllerCfg[controller.SSHKeyDisallowedTypes] = []interface{}{"ecdsa-sha2-nistp256"}
+	defer s.setup(c).Finish()
+	s.blockChecker.EXPECT().ChangeAllowed().Return(nil)
+
+	key1 := sshtesting.ValidKeyOne.Key + " user@host"
+	s.setAuthorizedKeys(c, key1)
+
+	rsaKey := pkisshtesting.RSA2048Key + " rsa@host"
+	ecdsaKey := pkisshtesting.ECDSAP256Key + " ecdsa@host"
+	ed25519Key := pkisshtesting.ED25519Key + " ed25519@host"
+
+	newAttrs := map[string]interface{}{
+		config.AuthorizedKeysKey: strings.Join([]string{key1, ed25519Key}, "\n"),
+	}
+	s.model.EXPECT().UpdateModelConfig(newAttrs, nil)
+
+	args := params.ModifyUserSSHKeys{
+		User: names.NewUserTag("admin").Name(),
+		Keys: []string{rsaKey, ecdsaKey, ed25519Key},
+	}
+	results, err := s.api.AddKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: apiservertesting.ServerError(fmt.Sprintf(
+				"2048 bit RSA key is below the minimum of 3072 bits set by controller config ssh-key-min-rsa-bits: %s", rsaKey))},
+			{Error: apiservertesting.ServerError(fmt.Sprintf(
+				`key algorithm "ecdsa-sha2-nistp256" is disallowed by controller config ssh-key-disallowed-types: %s`, ecdsaKey))},
+			{Error: nil},
+		},
+	})
+}
+
 func (s *keyManagerSuite) assertDeleteKeys(c *gc.C) {
 	key1 := sshtesting.ValidKeyOne.Key + " user@host"
 	key2 := sshtesting.ValidKeyTwo.Key
@@ -442,6 +578,33 @@ func (s *keyManagerSuite) TestImportJujuSystemKey(c *gc.C) {
 	})
 }
 
+func (s *keyManagerSuite) TestImportKeysKeyPolicy(c *gc.C) {
+	s.controllerCfg[controller.SSHKeyMinRSABits] = 3072
+	defer s.setup(c).Finish()
+	s.blockChecker.EXPECT().ChangeAllowed().Return(nil)
+
+	key1 := sshtesting.ValidKeyOne.Key + " user@host"
+	s.setAuthorizedKeys(c, key1)
+	newAttrs := map[string]interface{}{
+		config.AuthorizedKeysKey: strings.Join([]string{key1, pkisshtesting.ED25519Key}, "\n"),
+	}
+	s.model.EXPECT().UpdateModelConfig(newAttrs, nil)
+
+	args := params.ModifyUserSSHKeys{
+		User: names.NewUserTag("admin").String(),
+		Keys: []string{"lp:weakkeys"},
+	}
+	results, err := s.api.ImportKeys(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: apiservertesting.ServerError(
+				"2048 bit RSA key is below the minimum of 3072 bits set by controller config ssh-key-min-rsa-bits: " +
+					pkisshtesting.RSA2048Key)},
+		},
+	})
+}
+
 func (s *keyManagerSuite) TestBlockImportKeys(c *gc.C) {
 	defer s.setup(c).Finish()
 	s.blockChecker.EXPECT().ChangeAllowed().Return(errors.OperationBlockedError("TestImportKeys"))
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/keymanager (interfaces: Model,BlockChecker)
+// Source: github.com/juju/juju/apiserver/facades/client/keymanager (interfaces: Model,ControllerConfigGetter,BlockChecker)
 //
 // Generated by this command:
 //
-//	mockgen -package mocks -destination mocks/keymanager_mock.go github.com/juju/juju/apiserver/facades/client/keymanager Model,BlockChecker
+//	mockgen -package mocks -destination mocks/keymanager_mock.go github.com/juju/juju/apiserver/facades/client/keymanager Model,ControllerConfigGetter,BlockChecker
 //
 
 // Package mocks is a generated GoMock package.
@@ -12,6 +12,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	controller "github.com/juju/juju/controller"
 	config "github.com/juju/juju/environs/config"
 	state "github.com/juju/juju/state"
 	names "github.com/juju/names/v5"
@@ -89,6 +90,44 @@ func (mr *MockModelMockRecorder) UpdateModelConfig(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModelConfig", reflect.TypeOf((*MockModel)(nil).UpdateModelConfig), varargs...)
 }
 
+// MockControllerConfigGetter is a mock of ControllerConfigGetter interface.
+type MockControllerConfigGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigGetterMockRecorder
+}
+
+// MockControllerConfigGetterMockRecorder is the mock recorder for MockControllerConfigGetter.
+type MockControllerConfigGetterMockRecorder struct {
+	mock *MockControllerConfigGetter
+}
+
+// NewMockControllerConfigGetter creates a new mock instance.
+func NewMockControllerConfigGetter(ctrl *gomock.Controller) *MockControllerConfigGetter {
+	mock := &MockControllerConfigGetter{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigGetter) EXPECT() *MockControllerConfigGetterMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigGetter) ControllerConfig() (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig")
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigGetterMockRecorder) ControllerConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigGetter)(nil).ControllerConfig))
+}
+
 // MockBlockChecker is a mock of BlockChecker interface.
 type MockBlockChecker struct {
 	ctrl     *gomock.Controller
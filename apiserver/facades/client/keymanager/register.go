@@ -6,12 +6,14 @@ package keymanager
 import (
 	"reflect"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v5"
 
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 )
@@ -20,10 +22,21 @@ import (
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("KeyManager", 1, func(ctx facade.Context) (facade.Facade, error) {
 		return newFacadeV1(ctx)
+	}, reflect.TypeOf((*KeyManagerAPIV1)(nil)))
+	registry.MustRegister("KeyManager", 2, func(ctx facade.Context) (facade.Facade, error) {
+		return newFacade(ctx)
 	}, reflect.TypeOf((*KeyManagerAPI)(nil)))
 }
 
-func newFacadeV1(ctx facade.Context) (*KeyManagerAPI, error) {
+func newFacadeV1(ctx facade.Context) (*KeyManagerAPIV1, error) {
+	api, err := newFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &KeyManagerAPIV1{KeyManagerAPI: api}, nil
+}
+
+func newFacade(ctx facade.Context) (*KeyManagerAPI, error) {
 	// Only clients can access the key manager service.
 	authorizer := ctx.Auth()
 	if !authorizer.AuthClient() {
@@ -36,23 +49,29 @@ func newFacadeV1(ctx facade.Context) (*KeyManagerAPI, error) {
 	}
 	return newKeyManagerAPI(
 		m,
+		st,
 		authorizer,
 		common.NewBlockChecker(st),
+		clock.WallClock,
 		st.ControllerTag(),
 	), nil
 }
 
 func newKeyManagerAPI(
 	model Model,
+	controllerConfig ControllerConfigGetter,
 	authorizer facade.Authorizer,
 	check BlockChecker,
+	clock clock.Clock,
 	controllerTag names.ControllerTag,
 ) *KeyManagerAPI {
 	return &KeyManagerAPI{
-		model:         model,
-		authorizer:    authorizer,
-		check:         check,
-		controllerTag: controllerTag,
+		model:            model,
+		controllerConfig: controllerConfig,
+		authorizer:       authorizer,
+		check:            check,
+		clock:            clock,
+		controllerTag:    controllerTag,
 	}
 }
 
@@ -62,6 +81,12 @@ type Model interface {
 	UpdateModelConfig(map[string]interface{}, []string, ...state.ValidateConfigFunc) error
 }
 
+// ControllerConfigGetter provides the controller config, which holds
+// the policy that user ssh keys must meet.
+type ControllerConfigGetter interface {
+	ControllerConfig() (controller.Config, error)
+}
+
 type BlockChecker interface {
 	ChangeAllowed() error
 	RemoveAllowed() error
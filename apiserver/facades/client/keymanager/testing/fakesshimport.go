@@ -7,11 +7,14 @@ import (
 	"strings"
 
 	"github.com/juju/utils/v3/ssh/testing"
+
+	pkisshtesting "github.com/juju/juju/pki/ssh/testing"
 )
 
 var (
 	multiOneDup = testing.ValidKeyFour.Key + "\n" + testing.ValidKeyTwo.Key
 	SystemKey   = testing.ValidKeyThree.Key + " juju-system-key"
+	weakKeys    = pkisshtesting.RSA2048Key + "\n" + pkisshtesting.ED25519Key
 )
 
 var importResponses = map[string]string{
@@ -23,6 +26,7 @@ var importResponses = map[string]string{
 	"lp:multiinvalid": testing.MultiInvalid,
 	"lp:multionedup":  multiOneDup,
 	"lp:systemkey":    SystemKey,
+	"lp:weakkeys":     weakKeys,
 }
 
 var FakeImport = func(keyId string) (string, error) {
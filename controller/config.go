@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
@@ -19,6 +20,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/juju/juju/pki"
+	pkissh "github.com/juju/juju/pki/ssh"
 )
 
 const (
@@ -267,6 +269,14 @@ const (
 	// value of 0 means all queries will be output.
 	QueryTracingThreshold = "query-tracing-threshold"
 
+	// SSHKeyMinRSABits is the minimum size, in bits, of RSA public keys
+	// that users may add to models. A value of 0 disables the check.
+	SSHKeyMinRSABits = "ssh-key-min-rsa-bits"
+
+	// SSHKeyDisallowedTypes is a list of public key algorithms (e.g.
+	// "ssh-dss") that users may not add to models.
+	SSHKeyDisallowedTypes = "ssh-key-disallowed-types"
+
 	// JujudControllerSnapSource returns the source for the controller snap.
 	// Can be set to "legacy", "snapstore", "local" or "local-dangerous".
 	// Cannot be changed.
@@ -283,6 +293,9 @@ const (
 	// download requests initiated by unit agents for any application on the controller.
 	DefaultControllerResourceDownloadLimit = 0
 
+	// DefaultSSHKeyMinRSABits disables the minimum RSA key size check.
+	DefaultSSHKeyMinRSABits = 0
+
 	// DefaultAgentRateLimitMax allows the first 10 agents to connect without
 	// any issue. After that the rate limiting kicks in.
 	DefaultAgentRateLimitMax = 10
@@ -455,6 +468,8 @@ var (
 		QueryTracingEnabled,
 		QueryTracingThreshold,
 		JujudControllerSnapSource,
+		SSHKeyMinRSABits,
+		SSHKeyDisallowedTypes,
 	}
 
 	// For backwards compatibility, we must include "anything", "juju-apiserver"
@@ -508,6 +523,8 @@ var (
 		PublicDNSAddress,
 		QueryTracingEnabled,
 		QueryTracingThreshold,
+		SSHKeyDisallowedTypes,
+		SSHKeyMinRSABits,
 	)
 
 	// DefaultAuditLogExcludeMethods is the default list of methods to
@@ -773,6 +790,42 @@ func (c Config) AuditLogExcludeMethods() set.Strings {
 	return set.NewStrings(DefaultAuditLogExcludeMethods...)
 }
 
+// SSHKeyMinRSABits returns the minimum size, in bits, of RSA public keys
+// that users may add to models.
+func (c Config) SSHKeyMinRSABits() int {
+	switch v := c[SSHKeyMinRSABits].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		// nil type shows up here
+	}
+	return DefaultSSHKeyMinRSABits
+}
+
+// SSHKeyDisallowedTypes returns the set of public key algorithms that
+// users may not add to models.
+func (c Config) SSHKeyDisallowedTypes() set.Strings {
+	if value, ok := c[SSHKeyDisallowedTypes]; ok {
+		value := value.([]interface{})
+		items := set.NewStrings()
+		for _, item := range value {
+			items.Add(item.(string))
+		}
+		return items
+	}
+	return set.NewStrings()
+}
+
+// SSHKeyPolicy returns the policy that user ssh public keys must meet.
+func (c Config) SSHKeyPolicy() pkissh.KeyPolicy {
+	return pkissh.KeyPolicy{
+		MinRSABits:           c.SSHKeyMinRSABits(),
+		DisallowedAlgorithms: c.SSHKeyDisallowedTypes(),
+	}
+}
+
 // Features returns the controller config set features flags.
 func (c Config) Features() set.Strings {
 	features := set.NewStrings()
@@ -1260,6 +1313,24 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[SSHKeyMinRSABits].(int); ok {
+		if v < 0 {
+			return errors.Errorf("negative %s (%d) not valid, use 0 to disable the check", SSHKeyMinRSABits, v)
+		}
+	}
+
+	if v, ok := c[SSHKeyDisallowedTypes].([]interface{}); ok {
+		for _, keyType := range v {
+			keyType := keyType.(string)
+			if !pkissh.PublicKeyAlgorithms.Contains(keyType) {
+				return errors.Errorf(
+					"invalid %s: unknown key type %q, expected one of %s",
+					SSHKeyDisallowedTypes, keyType, strings.Join(pkissh.PublicKeyAlgorithms.SortedValues(), ", "),
+				)
+			}
+		}
+	}
+
 	if v, ok := c[JujudControllerSnapSource].(string); ok {
 		switch v {
 		case "legacy": // TODO(jujud-controller-snap): remove once jujud-controller snap is fully implemented.
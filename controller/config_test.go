@@ -384,6 +384,18 @@ var newConfigTests = []struct {
 		controller.JujudControllerSnapSource: "latest/stable",
 	},
 	expectError: `jujud-controller-snap-source value "latest/stable" must be one of legacy, snapstore, local or local-dangerous.`,
+}, {
+	about: "ssh-key-min-rsa-bits cannot be negative",
+	config: controller.Config{
+		controller.SSHKeyMinRSABits: "-1",
+	},
+	expectError: `negative ssh-key-min-rsa-bits \(-1\) not valid, use 0 to disable the check`,
+}, {
+	about: "unknown ssh-key-disallowed-types value",
+	config: controller.Config{
+		controller.SSHKeyDisallowedTypes: []interface{}{"ssh-dss", "ssh-foo"},
+	},
+	expectError: `invalid ssh-key-disallowed-types: unknown key type "ssh-foo", expected one of .*`,
 }, {
 	about: "empty controller name",
 	config: controller.Config{
@@ -436,6 +448,24 @@ func (s *ConfigSuite) TestResourceDownloadLimits(c *gc.C) {
 	c.Assert(cfg.ControllerResourceDownloadLimit(), gc.Equals, 666)
 }
 
+func (s *ConfigSuite) TestSSHKeyPolicy(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"ssh-key-min-rsa-bits":     "3072",
+			"ssh-key-disallowed-types": []interface{}{"ssh-dss"},
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.SSHKeyMinRSABits(), gc.Equals, 3072)
+	c.Assert(cfg.SSHKeyDisallowedTypes(), gc.DeepEquals, set.NewStrings("ssh-dss"))
+
+	policy := cfg.SSHKeyPolicy()
+	c.Assert(policy.MinRSABits, gc.Equals, 3072)
+	c.Assert(policy.DisallowedAlgorithms, gc.DeepEquals, set.NewStrings("ssh-dss"))
+}
+
 func (s *ConfigSuite) TestLogConfigValues(c *gc.C) {
 	c.Assert(controller.AllowedUpdateConfigAttributes.Contains(controller.ModelLogsSize), jc.IsTrue)
 
@@ -789,6 +819,8 @@ func (s *ConfigSuite) TestDefaults(c *gc.C) {
 	c.Assert(cfg.ControllerResourceDownloadLimit(), gc.Equals, controller.DefaultControllerResourceDownloadLimit)
 	c.Assert(cfg.QueryTracingEnabled(), gc.Equals, controller.DefaultQueryTracingEnabled)
 	c.Assert(cfg.QueryTracingThreshold(), gc.Equals, controller.DefaultQueryTracingThreshold)
+	c.Assert(cfg.SSHKeyMinRSABits(), gc.Equals, controller.DefaultSSHKeyMinRSABits)
+	c.Assert(cfg.SSHKeyDisallowedTypes(), gc.HasLen, 0)
 }
 
 func (s *ConfigSuite) TestAgentLogfile(c *gc.C) {
@@ -59,6 +59,8 @@ var configChecker = schema.FieldMap(schema.Fields{
 	QueryTracingEnabled:              schema.Bool(),
 	QueryTracingThreshold:            schema.TimeDuration(),
 	JujudControllerSnapSource:        schema.String(),
+	SSHKeyMinRSABits:                 schema.ForceInt(),
+	SSHKeyDisallowedTypes:            schema.List(schema.String()),
 }, schema.Defaults{
 	AgentRateLimitMax:                schema.Omit,
 	AgentRateLimitRate:               schema.Omit,
@@ -107,6 +109,8 @@ var configChecker = schema.FieldMap(schema.Fields{
 	QueryTracingEnabled:              DefaultQueryTracingEnabled,
 	QueryTracingThreshold:            DefaultQueryTracingThreshold,
 	JujudControllerSnapSource:        DefaultJujudControllerSnapSource,
+	SSHKeyMinRSABits:                 schema.Omit,
+	SSHKeyDisallowedTypes:            schema.Omit,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -312,4 +316,12 @@ will be output if tracing is enabled.`,
 		Type:        environschema.Tstring,
 		Description: `The source for the jujud-controller snap.`,
 	},
+	SSHKeyMinRSABits: {
+		Type:        environschema.Tint,
+		Description: `The minimum size, in bits, of RSA ssh keys that users may add to models (0 disables the check)`,
+	},
+	SSHKeyDisallowedTypes: {
+		Type:        environschema.Tlist,
+		Description: `The list of ssh key types (e.g. "ssh-dss") that users may not add to models`,
+	},
 }
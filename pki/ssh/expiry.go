@@ -0,0 +1,81 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+// expiryTimeOption is the authorized_keys option that OpenSSH uses to
+// limit the validity of a key.
+const expiryTimeOption = "expiry-time"
+
+// ErrExpiryTimeNotUTC is returned for an expiry-time without a trailing
+// "Z". OpenSSH reads such timespecs in the local timezone of the machine
+// checking the key, so they do not name a single point in time.
+const ErrExpiryTimeNotUTC = errors.ConstError("expiry-time not in UTC")
+
+// expiryTimeLayouts are the timespec forms accepted by OpenSSH for the
+// expiry-time option, before the optional trailing "Z".
+var expiryTimeLayouts = []string{
+	"20060102",
+	"200601021504",
+	"20060102150405",
+}
+
+// ExpiryTime returns the expiry-time option of the authorized_keys
+// formatted key as written. The returned bool is false if the key has no
+// expiry-time.
+func ExpiryTime(key string) (string, bool, error) {
+	_, _, options, _, err := cryptossh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return "", false, errors.NewNotValid(err, "ssh key")
+	}
+	for _, option := range options {
+		name, value, ok := strings.Cut(option, "=")
+		if ok && strings.EqualFold(name, expiryTimeOption) {
+			return strings.Trim(value, `"`), true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// KeyExpiry returns the time at which the authorized_keys formatted key
+// stops being valid, as given by its expiry-time option. The returned bool
+// is false if the key has no expiry. An error satisfying
+// ErrExpiryTimeNotUTC is returned if the expiry-time is valid but lacks
+// the trailing "Z".
+func KeyExpiry(key string) (time.Time, bool, error) {
+	value, ok, err := ExpiryTime(key)
+	if err != nil || !ok {
+		return time.Time{}, false, errors.Trace(err)
+	}
+	expiry, err := parseExpiryTime(value)
+	if err != nil {
+		return time.Time{}, false, errors.Trace(err)
+	}
+	return expiry, true, nil
+}
+
+func parseExpiryTime(value string) (time.Time, error) {
+	timespec, utc := strings.CutSuffix(value, "Z")
+	for _, layout := range expiryTimeLayouts {
+		if len(layout) != len(timespec) {
+			continue
+		}
+		t, err := time.ParseInLocation(layout, timespec, time.UTC)
+		if err != nil {
+			continue
+		}
+		if !utc {
+			return time.Time{}, errors.Annotatef(ErrExpiryTimeNotUTC, "%s %q", expiryTimeOption, value)
+		}
+		return t, nil
+	}
+	return time.Time{}, errors.NotValidf("%s %q", expiryTimeOption, value)
+}
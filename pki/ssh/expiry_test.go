@@ -0,0 +1,76 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/pki/ssh"
+	pkisshtesting "github.com/juju/juju/pki/ssh/testing"
+)
+
+type ExpirySuite struct{}
+
+var _ = gc.Suite(&ExpirySuite{})
+
+func (s *ExpirySuite) TestNoExpiry(c *gc.C) {
+	_, ok, err := ssh.KeyExpiry(pkisshtesting.ED25519Key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ok, jc.IsFalse)
+
+	_, ok, err = ssh.KeyExpiry(`no-pty ` + pkisshtesting.ED25519Key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *ExpirySuite) TestExpiry(c *gc.C) {
+	tests := []struct {
+		timespec string
+		expected time.Time
+	}{
+		{timespec: "20261116Z", expected: time.Date(2026, 11, 16, 0, 0, 0, 0, time.UTC)},
+		{timespec: "202611161230Z", expected: time.Date(2026, 11, 16, 12, 30, 0, 0, time.UTC)},
+		{timespec: "20261116123045Z", expected: time.Date(2026, 11, 16, 12, 30, 45, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		expiry, ok, err := ssh.KeyExpiry(`no-pty,expiry-time="` + test.timespec + `" ` + pkisshtesting.ED25519Key)
+		c.Check(err, jc.ErrorIsNil, gc.Commentf("timespec %s", test.timespec))
+		c.Check(ok, jc.IsTrue, gc.Commentf("timespec %s", test.timespec))
+		c.Check(expiry.Equal(test.expected), jc.IsTrue, gc.Commentf("timespec %s", test.timespec))
+	}
+}
+
+func (s *ExpirySuite) TestExpiryNotUTC(c *gc.C) {
+	for _, timespec := range []string{"20261116", "202611161230", "20261116123045"} {
+		_, _, err := ssh.KeyExpiry(`expiry-time="` + timespec + `" ` + pkisshtesting.ED25519Key)
+		c.Check(err, jc.ErrorIs, ssh.ErrExpiryTimeNotUTC, gc.Commentf("timespec %s", timespec))
+		c.Check(err, gc.ErrorMatches, `expiry-time "`+timespec+`": expiry-time not in UTC`)
+	}
+}
+
+func (s *ExpirySuite) TestExpiryTime(c *gc.C) {
+	timespec, ok, err := ssh.ExpiryTime(`no-pty,expiry-time="20261116" ` + pkisshtesting.ED25519Key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(timespec, gc.Equals, "20261116")
+
+	_, ok, err = ssh.ExpiryTime(pkisshtesting.ED25519Key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *ExpirySuite) TestInvalidExpiry(c *gc.C) {
+	_, _, err := ssh.KeyExpiry(`expiry-time="2026-11-16" ` + pkisshtesting.ED25519Key)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(err, gc.ErrorMatches, `expiry-time "2026-11-16" not valid`)
+}
+
+func (s *ExpirySuite) TestInvalidKey(c *gc.C) {
+	_, _, err := ssh.KeyExpiry("ssh-rsa not-a-key")
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
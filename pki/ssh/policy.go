@@ -0,0 +1,79 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+// PublicKeyAlgorithms is the set of public key algorithms that a
+// KeyPolicy may refer to.
+var PublicKeyAlgorithms = set.NewStrings(
+	cryptossh.KeyAlgoRSA,
+	cryptossh.KeyAlgoDSA,
+	cryptossh.KeyAlgoECDSA256,
+	cryptossh.KeyAlgoECDSA384,
+	cryptossh.KeyAlgoECDSA521,
+	cryptossh.KeyAlgoSKECDSA256,
+	cryptossh.KeyAlgoED25519,
+	cryptossh.KeyAlgoSKED25519,
+)
+
+// KeyPolicy describes the minimum requirements that a user's public ssh
+// key must meet. It is configured through the ssh-key-min-rsa-bits and
+// ssh-key-disallowed-types controller config keys, which its errors name.
+// The zero value accepts every key.
+type KeyPolicy struct {
+	// MinRSABits is the minimum modulus size, in bits, of RSA keys.
+	// A value of 0 disables the check.
+	MinRSABits int
+
+	// DisallowedAlgorithms holds the public key algorithms, as they
+	// appear in an authorized_keys line (e.g. "ssh-dss"), that are
+	// rejected outright.
+	DisallowedAlgorithms set.Strings
+}
+
+// CheckAuthorisedKey parses the authorized_keys formatted key and checks
+// it against the policy. An error satisfying errors.NotValid is returned
+// if the key cannot be parsed or does not meet the policy.
+func (p KeyPolicy) CheckAuthorisedKey(key string) error {
+	publicKey, _, _, _, err := cryptossh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return errors.NewNotValid(err, "ssh key")
+	}
+	return p.CheckPublicKey(publicKey)
+}
+
+// CheckPublicKey checks the public key against the policy, returning an
+// error satisfying errors.NotValid that names the violated requirement.
+func (p KeyPolicy) CheckPublicKey(key cryptossh.PublicKey) error {
+	keyType := key.Type()
+	if p.DisallowedAlgorithms.Contains(keyType) {
+		return errors.NewNotValid(nil, fmt.Sprintf(
+			"key algorithm %q is disallowed by controller config ssh-key-disallowed-types", keyType))
+	}
+	if keyType != cryptossh.KeyAlgoRSA || p.MinRSABits <= 0 {
+		return nil
+	}
+	cryptoKey, ok := key.(cryptossh.CryptoPublicKey)
+	if !ok {
+		return errors.NotValidf("RSA public key")
+	}
+	rsaKey, ok := cryptoKey.CryptoPublicKey().(*rsa.PublicKey)
+	if !ok {
+		return errors.NotValidf("RSA public key")
+	}
+	if bits := rsaKey.N.BitLen(); bits < p.MinRSABits {
+		return errors.NewNotValid(nil, fmt.Sprintf(
+			"%d bit RSA key is below the minimum of %d bits set by controller config ssh-key-min-rsa-bits",
+			bits, p.MinRSABits))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh_test
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	cryptossh "golang.org/x/crypto/ssh"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/pki/ssh"
+	pkisshtesting "github.com/juju/juju/pki/ssh/testing"
+)
+
+type PolicySuite struct {
+}
+
+var _ = gc.Suite(&PolicySuite{})
+
+func (s *PolicySuite) TestZeroPolicyAcceptsAll(c *gc.C) {
+	for _, key := range []string{pkisshtesting.RSA2048Key, pkisshtesting.ECDSAP256Key, pkisshtesting.ED25519Key} {
+		err := ssh.KeyPolicy{}.CheckAuthorisedKey(key)
+		c.Check(err, jc.ErrorIsNil)
+	}
+}
+
+func (s *PolicySuite) TestMinRSABits(c *gc.C) {
+	policy := ssh.KeyPolicy{MinRSABits: 3072}
+
+	err := policy.CheckAuthorisedKey(pkisshtesting.RSA2048Key)
+	c.Check(err, jc.Satisfies, errors.IsNotValid)
+	c.Check(err, gc.ErrorMatches, `2048 bit RSA key is below the minimum of 3072 bits set by controller config ssh-key-min-rsa-bits`)
+
+	err = policy.CheckAuthorisedKey(pkisshtesting.RSA3072Key)
+	c.Check(err, jc.ErrorIsNil)
+
+	// The minimum only applies to RSA keys.
+	err = policy.CheckAuthorisedKey(pkisshtesting.ED25519Key)
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *PolicySuite) TestDisallowedAlgorithms(c *gc.C) {
+	policy := ssh.KeyPolicy{
+		DisallowedAlgorithms: set.NewStrings(cryptossh.KeyAlgoDSA, cryptossh.KeyAlgoECDSA256),
+	}
+
+	err := policy.CheckAuthorisedKey(pkisshtesting.ECDSAP256Key)
+	c.Check(err, jc.Satisfies, errors.IsNotValid)
+	c.Check(err, gc.ErrorMatches, `key algorithm "ecdsa-sha2-nistp256" is disallowed by controller config ssh-key-disallowed-types`)
+
+	err = policy.CheckAuthorisedKey(pkisshtesting.ED25519Key)
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *PolicySuite) TestInvalidKey(c *gc.C) {
+	err := ssh.KeyPolicy{}.CheckAuthorisedKey("ssh-rsa not-a-key")
+	c.Check(err, jc.Satisfies, errors.IsNotValid)
+	c.Check(err, gc.ErrorMatches, `ssh key: .*`)
+}
+
+func (s *PolicySuite) TestPublicKeyAlgorithms(c *gc.C) {
+	c.Check(ssh.PublicKeyAlgorithms.Contains(cryptossh.KeyAlgoDSA), jc.IsTrue)
+	c.Check(ssh.PublicKeyAlgorithms.Contains(cryptossh.KeyAlgoED25519), jc.IsTrue)
+	c.Check(ssh.PublicKeyAlgorithms.Contains("ssh-unknown"), jc.IsFalse)
+}
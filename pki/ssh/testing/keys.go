@@ -0,0 +1,20 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+// Authorised key lines, without comments, for exercising key policy and
+// key option handling. The private halves were discarded.
+const (
+	// RSA2048Key is an RSA public key with a 2048 bit modulus.
+	RSA2048Key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCYTdUWqLIrqiisA9x+Y+q3Me/f0Bbim3k6TJLbmfQtDbVfHHZ2Wrb1VFHBUAoYzjneMRZzpjIoj9MAn16Q/lBbLhgJY6Up/btB8QA2hjsKxEuxPrD03yTonOOLBmZ/o8JcO+aaAs1bcN/tDklbqsF4Xm0CH4TeIfhoxaKTwEjBR+JmIjQPzwG+HaZ4uW6DJjb+n4JhR6rAV6kgC+sBE5gt1AEvEfYtuG0z4dxm1+l4psBPAu1oWNA8NMw2zU8eleU/Foh1YpWbyXBLFALgSOvs1m1HxEI4UCrT2D3cjP0PqZrR+AP50iquaPo22y8SJL8dEdfkf03BaM5cvpDr0t/j"
+
+	// RSA3072Key is an RSA public key with a 3072 bit modulus.
+	RSA3072Key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDDEY46vM+K6uXcYTJx4mZZYJM8Xeljm3u6rm0Y/l8neSKMlhEOME4WYetUApHOxEvRBk2ZjBMzuPGKrj6P432bXBStsNWbBWtcZBxHPmsYchtY6Mpk6bTzOGhCIh6b95r3UupUHi+Wc5TVMhfJl6Myj+T0zDxQ/tam8zu9HVNxDN5DkRymzMUv4UQwaTlvAU2DeDhlpgXR09H9UXIDc9dEsjoB6JefK688X5E04EPUXfNGCF64kmYCPF8t1l2g7bymN+Oc7/8fsiG45lCSBFg6eHtAk2gE8UcTcIvaH5eF0JHfdHDT9zgWpXPrk8dH7Ge/2ul851sLYFhmhC+Ygg2gHLQIU9plsjjg3O+Q4lpv8f6jY41mA6swX0QpKHVJ5bKrWYUyzlizcDPB27S7SCbVzXxvXu77OA0QEMYJ+GFSaHBdVFi9SCOI2qZJUYHxifdD3XffDUxAZ+pvG9kdjB8yH4ZTPz/Yg+k9ig1cBsHtjwR6UlJhEgZQNMyPJvS0JY0="
+
+	// ECDSAP256Key is an ECDSA P-256 public key.
+	ECDSAP256Key = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBPQAG+tRFvij29qWqkHEP6tBwS3J9bJ0ZrqCUBWPxx5diorIZmTnVITw+vofKZcntLiblHpfwHqvYTUSpuhRhxk="
+
+	// ED25519Key is an ed25519 public key.
+	ED25519Key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICQ2g2w1jQ5S3VWFReQsyyjt/nNTeFyxZyirdgVMNH9T"
+)
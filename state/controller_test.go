@@ -71,6 +71,8 @@ func (s *ControllerSuite) TestControllerAndModelConfigInitialisation(c *gc.C) {
 		controller.QueryTracingEnabled,
 		controller.QueryTracingThreshold,
 		controller.JujudControllerSnapSource,
+		controller.SSHKeyMinRSABits,
+		controller.SSHKeyDisallowedTypes,
 	)
 	for _, controllerAttr := range controller.ControllerOnlyConfigAttributes {
 		v, ok := controllerSettings.Get(controllerAttr)